@@ -3,7 +3,10 @@ package chaincode
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/hyperledger/fabric-contract-api-go/contractapi"
 )
@@ -12,29 +15,137 @@ type Token struct {
 	contractapi.Contract
 }
 
+// Role identifies a permission grantable to a client identity, modeled on
+// OpenZeppelin's AccessControl.
+type Role string
+
+const (
+	DefaultAdminRole Role = "DEFAULT_ADMIN_ROLE"
+	MinterRole       Role = "MINTER_ROLE"
+	BurnerRole       Role = "BURNER_ROLE"
+	PauserRole       Role = "PAUSER_ROLE"
+)
+
 const (
 	balancePrefix   = "balance"
 	allowancePrefix = "allowance"
+	rolePrefix      = "role"
+	frozenPrefix    = "frozen"
 	nameKey         = "name"
 	symbolKey       = "symbol"
 	decimalsKey     = "decimals"
 	totalSupplyKey  = "totalSupply"
+	maxSupplyKey    = "maxSupply"
+	pausedKey       = "paused"
+	haltBlockKey    = "haltBlock"
+	blockCounterKey = "blockCounter"
 )
 
-func (t *Token) InitLedger(ctx contractapi.TransactionContextInterface, name string, symbol string, decimals string) error {
+// InitLedger sets up the token's metadata and grants every role to admin. If
+// admin is empty, the initializing client's own identity is used.
+func (t *Token) InitLedger(ctx contractapi.TransactionContextInterface, name string, symbol string, decimals string, maxSupply string, admin string) error {
 	nameBytes, _ := ctx.GetStub().GetState(nameKey)
 
 	if nameBytes != nil {
 		return fmt.Errorf("Contract is already initialized")
 	}
 
+	maxSupplyInt, err := parseBigInt(maxSupply)
+	if err != nil {
+		return err
+	}
+	if maxSupplyInt.Sign() < 0 {
+		return fmt.Errorf("maxSupply must not be negative")
+	}
+
+	decimalsInt, err := strconv.Atoi(decimals)
+	if err != nil || decimalsInt < 0 {
+		return fmt.Errorf("decimals must be a non-negative integer: %s", decimals)
+	}
+
+	if admin == "" {
+		admin, err = ctx.GetClientIdentity().GetID()
+		if err != nil {
+			return err
+		}
+	}
+
 	ctx.GetStub().PutState(nameKey, []byte(name))
 	ctx.GetStub().PutState(symbolKey, []byte(symbol))
 	ctx.GetStub().PutState(decimalsKey, []byte(decimals))
+	ctx.GetStub().PutState(maxSupplyKey, []byte(maxSupplyInt.String()))
+
+	for _, role := range []Role{DefaultAdminRole, MinterRole, BurnerRole, PauserRole} {
+		if err := t.grantRoleUnchecked(ctx, role, admin); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// parseBigInt parses a canonical base-10 integer string, rejecting anything
+// that isn't a clean big.Int (notably empty strings and malformed input that
+// strconv.Atoi would have silently truncated at machine-word size).
+func parseBigInt(s string) (*big.Int, error) {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer value: %s", s)
+	}
+	return v, nil
+}
+
+// getDecimals returns the token's configured decimals, defaulting to 0 if
+// InitLedger has not set one yet.
+func (t *Token) getDecimals(ctx contractapi.TransactionContextInterface) (int, error) {
+	decimalsBytes, err := ctx.GetStub().GetState(decimalsKey)
+	if err != nil {
+		return 0, err
+	}
+	if decimalsBytes == nil {
+		return 0, nil
+	}
+	return strconv.Atoi(string(decimalsBytes))
+}
+
+// humanToBaseUnits scales a human-readable decimal amount (e.g. "1.5") up
+// to the integer base units the ledger stores, using the token's decimals.
+// Plain integer amounts (e.g. "100") are scaled the same way, to
+// 100 * 10^decimals base units.
+func humanToBaseUnits(value string, decimals int) (*big.Int, error) {
+	v := value
+	neg := false
+	if strings.HasPrefix(v, "-") {
+		neg = true
+		v = v[1:]
+	}
+
+	parts := strings.SplitN(v, ".", 2)
+	wholePart := parts[0]
+	fracPart := ""
+	if len(parts) == 2 {
+		fracPart = parts[1]
+	}
+	if len(fracPart) > decimals {
+		return nil, fmt.Errorf("amount %s has more fractional digits than the %d configured decimals", value, decimals)
+	}
+	fracPart += strings.Repeat("0", decimals-len(fracPart))
+
+	digits := wholePart + fracPart
+	if digits == "" {
+		digits = "0"
+	}
+
+	result, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount: %s", value)
+	}
+	if neg {
+		result.Neg(result)
+	}
+	return result, nil
+}
+
 func (t *Token) TokenName(ctx contractapi.TransactionContextInterface) (string, error) {
 	nameBytes, err := ctx.GetStub().GetState(nameKey)
 	if err != nil {
@@ -68,8 +179,15 @@ func (t *Token) TotalSupply(ctx contractapi.TransactionContextInterface) (string
 }
 
 func (t *Token) BalanceOf(ctx contractapi.TransactionContextInterface, account string) (string, error) {
-	balanceKey := balancePrefix + account
-	balanceBytes, err := ctx.GetStub().GetState(balanceKey)
+	if err := validateAccountID(account); err != nil {
+		return "", err
+	}
+
+	key, err := balanceKey(ctx, account)
+	if err != nil {
+		return "", err
+	}
+	balanceBytes, err := ctx.GetStub().GetState(key)
 	if err != nil {
 		return "", err
 	}
@@ -79,122 +197,385 @@ func (t *Token) BalanceOf(ctx contractapi.TransactionContextInterface, account s
 	return string(balanceBytes), nil
 }
 
+// accountIDPattern bounds the character set and length client identities
+// and account arguments must fall within. Fabric client IDs are
+// base64url-ish MSP x509 identity strings; this also happens to reject the
+// empty string, which used to silently succeed and create a "balance" under
+// the bare key "balance".
+var accountIDPattern = regexp.MustCompile(`^[A-Za-z0-9+/=_.:-]{1,512}$`)
+
+// validateAccountID rejects account/from/to/owner/spender arguments that
+// can't be a real MSP identity, closing off empty-string and
+// composite-key-collision footguns before they reach state.
+func validateAccountID(id string) error {
+	if !accountIDPattern.MatchString(id) {
+		return fmt.Errorf("invalid account id: %q", id)
+	}
+	return nil
+}
+
+// balanceKey builds the balance~account composite key.
+func balanceKey(ctx contractapi.TransactionContextInterface, account string) (string, error) {
+	return ctx.GetStub().CreateCompositeKey(balancePrefix, []string{account})
+}
+
+// creditableBalance reads account's liquid balance for internal
+// credit-the-recipient paths (Transfer, TransferFrom, Unfreeze), treating an
+// account that has never held a balance as zero rather than an error.
+// This differs from the public BalanceOf query, which errors on an
+// unknown account; without this, the very first transfer to a fresh
+// address would always fail.
+func (t *Token) creditableBalance(ctx contractapi.TransactionContextInterface, account string) (*big.Int, error) {
+	key, err := balanceKey(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	balanceBytes, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return nil, err
+	}
+	if balanceBytes == nil {
+		return big.NewInt(0), nil
+	}
+	return parseBigInt(string(balanceBytes))
+}
+
+// allowanceKey builds the allowance~owner~spender composite key, which keeps
+// owner/spender unambiguous regardless of characters either ID contains
+// (plain string concatenation could collide, e.g. owner="ab"+spender="c" vs
+// owner="a"+spender="bc").
+func allowanceKey(ctx contractapi.TransactionContextInterface, owner string, spender string) (string, error) {
+	return ctx.GetStub().CreateCompositeKey(allowancePrefix, []string{owner, spender})
+}
+
 func (t *Token) Allowance(ctx contractapi.TransactionContextInterface, owner string, spender string) (string, error) {
-	allowanceKey := allowancePrefix + owner + spender
-	allowanceBytes, err := ctx.GetStub().GetState(allowanceKey)
+	if err := validateAccountID(owner); err != nil {
+		return "", err
+	}
+	if err := validateAccountID(spender); err != nil {
+		return "", err
+	}
+
+	key, err := allowanceKey(ctx, owner, spender)
+	if err != nil {
+		return "", err
+	}
+	allowanceBytes, err := ctx.GetStub().GetState(key)
 	if err != nil {
 		return "", err
 	}
 	if allowanceBytes == nil {
-		return "", fmt.Errorf("the allowance for %s from %s does not exist", spender, owner)
+		return "0", nil
 	}
 	return string(allowanceBytes), nil
 }
 
-func (t *Token) Transfer(ctx contractapi.TransactionContextInterface, from string, to string, amount string) error {
-	amountInt, err := strconv.Atoi(amount)
+// Approve sets the allowance spender may draw from the calling client's
+// balance, replacing any existing allowance. Callers racing to change an
+// allowance from a nonzero value to another nonzero value should prefer
+// IncreaseAllowance/DecreaseAllowance instead: a spender watching the
+// mempool can front-run a plain Approve to spend the old allowance before
+// the new one takes effect, then spend the new one too.
+func (t *Token) Approve(ctx contractapi.TransactionContextInterface, spender string, value string) error {
+	if err := validateAccountID(spender); err != nil {
+		return err
+	}
+
+	owner, err := ctx.GetClientIdentity().GetID()
+	if err != nil {
+		return err
+	}
+
+	decimals, err := t.getDecimals(ctx)
+	if err != nil {
+		return err
+	}
+	valueInt, err := humanToBaseUnits(value, decimals)
+	if err != nil {
+		return err
+	}
+	if valueInt.Sign() < 0 {
+		return fmt.Errorf("value must not be negative")
+	}
+
+	key, err := allowanceKey(ctx, owner, spender)
+	if err != nil {
+		return err
+	}
+	if err := ctx.GetStub().PutState(key, []byte(valueInt.String())); err != nil {
+		return err
+	}
+
+	return t.emitApproval(ctx, owner, spender, valueInt.String())
+}
+
+// IncreaseAllowance atomically adds addedValue to the allowance spender may
+// draw from the caller's balance. This, together with DecreaseAllowance,
+// avoids the classic approve race: because the change is relative rather
+// than absolute, a spender who sees both the old and new transaction can
+// only ever spend up to the originally intended total, not old+new.
+func (t *Token) IncreaseAllowance(ctx contractapi.TransactionContextInterface, spender string, addedValue string) error {
+	owner, err := ctx.GetClientIdentity().GetID()
+	if err != nil {
+		return err
+	}
+
+	current, err := t.Allowance(ctx, owner, spender)
+	if err != nil {
+		return err
+	}
+	currentInt, err := parseBigInt(current)
+	if err != nil {
+		return err
+	}
+
+	decimals, err := t.getDecimals(ctx)
+	if err != nil {
+		return err
+	}
+	addedInt, err := humanToBaseUnits(addedValue, decimals)
+	if err != nil {
+		return err
+	}
+	if addedInt.Sign() < 0 {
+		return fmt.Errorf("addedValue must not be negative")
+	}
+
+	newAllowance := new(big.Int).Add(currentInt, addedInt)
+
+	key, err := allowanceKey(ctx, owner, spender)
+	if err != nil {
+		return err
+	}
+	if err := ctx.GetStub().PutState(key, []byte(newAllowance.String())); err != nil {
+		return err
+	}
+
+	return t.emitApproval(ctx, owner, spender, newAllowance.String())
+}
+
+// DecreaseAllowance atomically subtracts subtractedValue from the allowance
+// spender may draw from the caller's balance. See IncreaseAllowance for why
+// this is preferred over a plain Approve when adjusting an existing
+// allowance.
+func (t *Token) DecreaseAllowance(ctx contractapi.TransactionContextInterface, spender string, subtractedValue string) error {
+	owner, err := ctx.GetClientIdentity().GetID()
+	if err != nil {
+		return err
+	}
+
+	current, err := t.Allowance(ctx, owner, spender)
+	if err != nil {
+		return err
+	}
+	currentInt, err := parseBigInt(current)
+	if err != nil {
+		return err
+	}
+
+	decimals, err := t.getDecimals(ctx)
+	if err != nil {
+		return err
+	}
+	subtractedInt, err := humanToBaseUnits(subtractedValue, decimals)
+	if err != nil {
+		return err
+	}
+	if subtractedInt.Sign() < 0 {
+		return fmt.Errorf("subtractedValue must not be negative")
+	}
+
+	newAllowance := new(big.Int).Sub(currentInt, subtractedInt)
+	if newAllowance.Sign() < 0 {
+		return fmt.Errorf("decreased allowance below zero")
+	}
+
+	key, err := allowanceKey(ctx, owner, spender)
+	if err != nil {
+		return err
+	}
+	if err := ctx.GetStub().PutState(key, []byte(newAllowance.String())); err != nil {
+		return err
+	}
+
+	return t.emitApproval(ctx, owner, spender, newAllowance.String())
+}
+
+func (t *Token) emitApproval(ctx contractapi.TransactionContextInterface, owner string, spender string, value string) error {
+	event := map[string]string{"owner": owner, "spender": spender, "value": value}
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return ctx.GetStub().SetEvent("Approval", eventJSON)
+}
+
+// Transfer moves amount from the calling client's own balance to to. The
+// sender is derived from ctx.GetClientIdentity().GetID() rather than taken
+// as an argument, so a caller cannot spoof another account's identity and
+// drain its balance; third-party moves must go through the
+// allowance-gated TransferFrom instead.
+func (t *Token) Transfer(ctx contractapi.TransactionContextInterface, to string, amount string) error {
+	if err := t.whenNotPaused(ctx); err != nil {
+		return err
+	}
+
+	from, err := ctx.GetClientIdentity().GetID()
+	if err != nil {
+		return err
+	}
+
+	if err := validateAccountID(to); err != nil {
+		return err
+	}
+
+	decimals, err := t.getDecimals(ctx)
+	if err != nil {
+		return err
+	}
+	amountInt, err := humanToBaseUnits(amount, decimals)
 	if err != nil {
 		return err
 	}
-	if amountInt <= 0 {
+	if amountInt.Sign() <= 0 {
 		return fmt.Errorf("amount must be greater than 0")
 	}
+
 	fromBalance, err := t.BalanceOf(ctx, from)
 	if err != nil {
 		return err
 	}
-	fromBalanceInt, err := strconv.Atoi(fromBalance)
+	fromBalanceInt, err := parseBigInt(fromBalance)
 	if err != nil {
 		return err
 	}
-	if fromBalanceInt < amountInt {
+	if fromBalanceInt.Cmp(amountInt) < 0 {
 		return fmt.Errorf("the account %s does not have enough balance", from)
 	}
-	toBalance, err := t.BalanceOf(ctx, to)
+
+	toBalanceInt, err := t.creditableBalance(ctx, to)
 	if err != nil {
 		return err
 	}
-	toBalanceInt, err := strconv.Atoi(toBalance)
+
+	newFromBalance := new(big.Int).Sub(fromBalanceInt, amountInt)
+	newToBalance := new(big.Int).Add(toBalanceInt, amountInt)
+	if newFromBalance.Sign() < 0 {
+		return fmt.Errorf("the account %s does not have enough balance", from)
+	}
+
+	fromBalanceKey, err := balanceKey(ctx, from)
 	if err != nil {
 		return err
 	}
-	fromBalanceInt = fromBalanceInt - amountInt
-	toBalanceInt = toBalanceInt + amountInt
-	fromBalance = strconv.Itoa(fromBalanceInt)
-	toBalance = strconv.Itoa(toBalanceInt)
-	fromBalanceKey := balancePrefix + from
-	toBalanceKey := balancePrefix + to
-	err = ctx.GetStub().PutState(fromBalanceKey, []byte(fromBalance))
+	toBalanceKey, err := balanceKey(ctx, to)
 	if err != nil {
 		return err
 	}
-	err = ctx.GetStub().PutState(toBalanceKey, []byte(toBalance))
-	if err != nil {
+	if err := ctx.GetStub().PutState(fromBalanceKey, []byte(newFromBalance.String())); err != nil {
 		return err
 	}
-	return nil
+	if err := ctx.GetStub().PutState(toBalanceKey, []byte(newToBalance.String())); err != nil {
+		return err
+	}
+	return t.emitTransfer(ctx, from, to, amountInt.String())
 }
 
 func (t *Token) TransferFrom(ctx contractapi.TransactionContextInterface, from string, to string, value string) error {
-	amountInt, err := strconv.Atoi(value)
+	if err := t.whenNotPaused(ctx); err != nil {
+		return err
+	}
+
+	if err := validateAccountID(from); err != nil {
+		return err
+	}
+	if err := validateAccountID(to); err != nil {
+		return err
+	}
+
+	decimals, err := t.getDecimals(ctx)
+	if err != nil {
+		return err
+	}
+	amountInt, err := humanToBaseUnits(value, decimals)
 	if err != nil {
 		return err
 	}
-	if amountInt <= 0 {
+	if amountInt.Sign() <= 0 {
 		return fmt.Errorf("amount must be greater than 0")
 	}
+
 	fromBalance, err := t.BalanceOf(ctx, from)
 	if err != nil {
 		return err
 	}
-	fromBalanceInt, err := strconv.Atoi(fromBalance)
+	fromBalanceInt, err := parseBigInt(fromBalance)
 	if err != nil {
 		return err
 	}
-	if fromBalanceInt < amountInt {
+	if fromBalanceInt.Cmp(amountInt) < 0 {
 		return fmt.Errorf("the account %s does not have enough balance", from)
 	}
+
 	allowance, err := t.Allowance(ctx, from, to)
 	if err != nil {
 		return err
 	}
-	allowanceInt, err := strconv.Atoi(allowance)
+	allowanceInt, err := parseBigInt(allowance)
 	if err != nil {
 		return err
 	}
-	if allowanceInt < amountInt {
+	if allowanceInt.Cmp(amountInt) < 0 {
 		return fmt.Errorf("the allowance for %s from %s is not enough", to, from)
 	}
-	toBalance, err := t.BalanceOf(ctx, to)
+
+	toBalanceInt, err := t.creditableBalance(ctx, to)
 	if err != nil {
 		return err
 	}
-	toBalanceInt, err := strconv.Atoi(toBalance)
+
+	newFromBalance := new(big.Int).Sub(fromBalanceInt, amountInt)
+	newToBalance := new(big.Int).Add(toBalanceInt, amountInt)
+	newAllowance := new(big.Int).Sub(allowanceInt, amountInt)
+	if newFromBalance.Sign() < 0 || newAllowance.Sign() < 0 {
+		return fmt.Errorf("transfer would underflow balance or allowance")
+	}
+
+	fromBalanceKey, err := balanceKey(ctx, from)
 	if err != nil {
 		return err
 	}
-	fromBalanceInt = fromBalanceInt - amountInt
-	toBalanceInt = toBalanceInt + amountInt
-	allowanceInt = allowanceInt - amountInt
-	fromBalance = strconv.Itoa(fromBalanceInt)
-	toBalance = strconv.Itoa(toBalanceInt)
-	allowance = strconv.Itoa(allowanceInt)
-	fromBalanceKey := balancePrefix + from
-	toBalanceKey := balancePrefix + to
-	allowanceKey := allowancePrefix + from + to
-	err = ctx.GetStub().PutState(fromBalanceKey, []byte(fromBalance))
+	toBalanceKey, err := balanceKey(ctx, to)
 	if err != nil {
 		return err
 	}
-	err = ctx.GetStub().PutState(toBalanceKey, []byte(toBalance))
+	allowKey, err := allowanceKey(ctx, from, to)
 	if err != nil {
 		return err
 	}
-	err = ctx.GetStub().PutState(allowanceKey, []byte(allowance))
+	if err := ctx.GetStub().PutState(fromBalanceKey, []byte(newFromBalance.String())); err != nil {
+		return err
+	}
+	if err := ctx.GetStub().PutState(toBalanceKey, []byte(newToBalance.String())); err != nil {
+		return err
+	}
+	if err := ctx.GetStub().PutState(allowKey, []byte(newAllowance.String())); err != nil {
+		return err
+	}
+	return t.emitTransfer(ctx, from, to, amountInt.String())
+}
+
+// emitTransfer emits the normalized Transfer event payload shared by
+// Transfer, TransferFrom, Mint (from == "") and Burn (to == ""), so
+// off-chain consumers can follow a single consistent event stream instead
+// of replaying per-method events.
+func (t *Token) emitTransfer(ctx contractapi.TransactionContextInterface, from string, to string, amount string) error {
+	event := map[string]string{"from": from, "to": to, "amount": amount}
+	eventJSON, err := json.Marshal(event)
 	if err != nil {
 		return err
 	}
-	return nil
+	return ctx.GetStub().SetEvent("Transfer", eventJSON)
 }
 
 func (t *Token) Mint(ctx contractapi.TransactionContextInterface, to string, amount string) error {
@@ -204,8 +585,17 @@ func (t *Token) Mint(ctx contractapi.TransactionContextInterface, to string, amo
 		return fmt.Errorf("contract is not initialized")
 	}
 
-	// Get the minter's ID (assuming it's the client's ID in this context)
-	minter, err := ctx.GetClientIdentity().GetID()
+	if err := t.whenNotPaused(ctx); err != nil {
+		return err
+	}
+
+	if err := t.requireRole(ctx, MinterRole); err != nil {
+		return err
+	}
+
+	if err := validateAccountID(to); err != nil {
+		return err
+	}
 
 	// Get the total supply from the ledger
 	totalSupplyBytes, err := ctx.GetStub().GetState(totalSupplyKey)
@@ -214,65 +604,74 @@ func (t *Token) Mint(ctx contractapi.TransactionContextInterface, to string, amo
 	}
 
 	// Check if total supply exists
-	var tSupply int
-	if totalSupplyBytes == nil {
-		tSupply = 0
-	} else {
-		tSupply, err = strconv.Atoi(string(totalSupplyBytes))
+	tSupply := big.NewInt(0)
+	if totalSupplyBytes != nil {
+		tSupply, err = parseBigInt(string(totalSupplyBytes))
 		if err != nil {
 			return err
 		}
 	}
 
 	// Get the balance of the recipient
-	toBalanceKey := balancePrefix + to
+	toBalanceKey, err := balanceKey(ctx, to)
+	if err != nil {
+		return err
+	}
 	toBalanceBytes, err := ctx.GetStub().GetState(toBalanceKey)
 	if err != nil {
 		return err
 	}
 
 	// Check if balance exists for the recipient
-	var balance int
-	if toBalanceBytes == nil {
-		balance = 0
-	} else {
-		balance, err = strconv.Atoi(string(toBalanceBytes))
+	balance := big.NewInt(0)
+	if toBalanceBytes != nil {
+		balance, err = parseBigInt(string(toBalanceBytes))
 		if err != nil {
 			return err
 		}
 	}
 
 	// Calculate the new balances
-	amountInt, err := strconv.Atoi(amount)
+	decimals, err := t.getDecimals(ctx)
 	if err != nil {
 		return err
 	}
-	newTotalSupply := tSupply + amountInt
-	newToBalance := balance + amountInt
-
-	// Write the updated states back to the ledger
-	err = ctx.GetStub().PutState(totalSupplyKey, []byte(strconv.Itoa(newTotalSupply)))
+	amountInt, err := humanToBaseUnits(amount, decimals)
 	if err != nil {
 		return err
 	}
-	err = ctx.GetStub().PutState(toBalanceKey, []byte(strconv.Itoa(newToBalance)))
+	if amountInt.Sign() <= 0 {
+		return fmt.Errorf("amount must be greater than 0")
+	}
+
+	newTotalSupply := new(big.Int).Add(tSupply, amountInt)
+	newToBalance := new(big.Int).Add(balance, amountInt)
+
+	maxSupplyBytes, err := ctx.GetStub().GetState(maxSupplyKey)
 	if err != nil {
 		return err
 	}
+	if maxSupplyBytes != nil {
+		maxSupply, err := parseBigInt(string(maxSupplyBytes))
+		if err != nil {
+			return err
+		}
+		if maxSupply.Sign() > 0 && newTotalSupply.Cmp(maxSupply) > 0 {
+			return fmt.Errorf("minting %s would push total supply past the configured max supply of %s", amountInt.String(), maxSupply.String())
+		}
+	}
 
-	// Emit the Mint event
-	mintEvent := map[string]string{
-		"to":     to,
-		"amount": amount,
-		"minter": minter,
+	// Write the updated states back to the ledger
+	err = ctx.GetStub().PutState(totalSupplyKey, []byte(newTotalSupply.String()))
+	if err != nil {
+		return err
 	}
-	mintEventJSON, err := json.Marshal(mintEvent)
+	err = ctx.GetStub().PutState(toBalanceKey, []byte(newToBalance.String()))
 	if err != nil {
 		return err
 	}
-	ctx.GetStub().SetEvent("Mint", mintEventJSON)
 
-	return nil
+	return t.emitTransfer(ctx, "", to, amountInt.String())
 }
 
 func (t *Token) Burn(ctx contractapi.TransactionContextInterface, from string, amount string) error {
@@ -282,59 +681,80 @@ func (t *Token) Burn(ctx contractapi.TransactionContextInterface, from string, a
 		return fmt.Errorf("contract is not initialized")
 	}
 
-	// Get the total supply from the ledger
-	totalSupplyBytes, err := ctx.GetStub().GetState(totalSupplyKey)
-	if err != nil {
+	if err := t.whenNotPaused(ctx); err != nil {
 		return err
 	}
 
-	totalSupply, err := strconv.Atoi(string(totalSupplyBytes))
-	if err != nil {
+	if err := t.requireRole(ctx, BurnerRole); err != nil {
 		return err
 	}
 
-	// Get the balance of the account to burn from
-	fromBalanceKey := balancePrefix + from
-	fromBalanceBytes, err := ctx.GetStub().GetState(fromBalanceKey)
-	if err != nil {
+	if err := validateAccountID(from); err != nil {
 		return err
 	}
 
-	fromBalance, err := strconv.Atoi(string(fromBalanceBytes))
+	// Get the total supply from the ledger
+	totalSupplyBytes, err := ctx.GetStub().GetState(totalSupplyKey)
 	if err != nil {
 		return err
 	}
 
-	// Calculate the new balances after burning
-	amountInt, err := strconv.Atoi(amount)
-	if err != nil {
-		return err
+	totalSupply := big.NewInt(0)
+	if totalSupplyBytes != nil {
+		totalSupply, err = parseBigInt(string(totalSupplyBytes))
+		if err != nil {
+			return err
+		}
 	}
-	newTotalSupply := totalSupply - amountInt
-	newFromBalance := fromBalance - amountInt
 
-	// Update the ledger with the new balances
-	err = ctx.GetStub().PutState(totalSupplyKey, []byte(strconv.Itoa(newTotalSupply)))
+	// Get the balance of the account to burn from
+	fromBalanceKey, err := balanceKey(ctx, from)
 	if err != nil {
 		return err
 	}
-	err = ctx.GetStub().PutState(fromBalanceKey, []byte(strconv.Itoa(newFromBalance)))
+	fromBalanceBytes, err := ctx.GetStub().GetState(fromBalanceKey)
 	if err != nil {
 		return err
 	}
 
-	// Emit the Burn event
-	burnEvent := map[string]string{
-		"from":   from,
-		"amount": amount,
+	fromBalance := big.NewInt(0)
+	if fromBalanceBytes != nil {
+		fromBalance, err = parseBigInt(string(fromBalanceBytes))
+		if err != nil {
+			return err
+		}
 	}
-	burnEventJSON, err := json.Marshal(burnEvent)
+
+	// Calculate the new balances after burning
+	decimals, err := t.getDecimals(ctx)
 	if err != nil {
 		return err
 	}
-	ctx.GetStub().SetEvent("Burn", burnEventJSON)
-
-	return nil
+	amountInt, err := humanToBaseUnits(amount, decimals)
+	if err != nil {
+		return err
+	}
+	if amountInt.Sign() <= 0 {
+		return fmt.Errorf("amount must be greater than 0")
+	}
+
+	newTotalSupply := new(big.Int).Sub(totalSupply, amountInt)
+	newFromBalance := new(big.Int).Sub(fromBalance, amountInt)
+	if newTotalSupply.Sign() < 0 || newFromBalance.Sign() < 0 {
+		return fmt.Errorf("burn amount %s exceeds available balance or total supply", amountInt.String())
+	}
+
+	// Update the ledger with the new balances
+	err = ctx.GetStub().PutState(totalSupplyKey, []byte(newTotalSupply.String()))
+	if err != nil {
+		return err
+	}
+	err = ctx.GetStub().PutState(fromBalanceKey, []byte(newFromBalance.String()))
+	if err != nil {
+		return err
+	}
+
+	return t.emitTransfer(ctx, from, "", amountInt.String())
 }
 
 func (t *Token) CheckInitialized(ctx contractapi.TransactionContextInterface) (bool, error) {
@@ -347,3 +767,610 @@ func (t *Token) CheckInitialized(ctx contractapi.TransactionContextInterface) (b
 	}
 	return true, nil
 }
+
+// Pause halts the methods gated by whenNotPaused until Unpause is called.
+// Only a PauserRole holder may call it; InitLedger grants that role to the
+// initial admin, and GrantRole/RevokeRole manage it afterwards.
+func (t *Token) Pause(ctx contractapi.TransactionContextInterface) error {
+	if err := t.requireRole(ctx, PauserRole); err != nil {
+		return err
+	}
+
+	if err := ctx.GetStub().PutState(pausedKey, []byte("true")); err != nil {
+		return err
+	}
+
+	return ctx.GetStub().SetEvent("Paused", []byte("{}"))
+}
+
+// Unpause resumes state-mutating token operations.
+func (t *Token) Unpause(ctx contractapi.TransactionContextInterface) error {
+	if err := t.requireRole(ctx, PauserRole); err != nil {
+		return err
+	}
+
+	if err := ctx.GetStub().PutState(pausedKey, []byte("false")); err != nil {
+		return err
+	}
+
+	return ctx.GetStub().SetEvent("Unpaused", []byte("{}"))
+}
+
+// IsPaused reports whether the contract is currently paused.
+func (t *Token) IsPaused(ctx contractapi.TransactionContextInterface) (bool, error) {
+	pausedBytes, err := ctx.GetStub().GetState(pausedKey)
+	if err != nil {
+		return false, err
+	}
+	return pausedBytes != nil && string(pausedBytes) == "true", nil
+}
+
+// SetHaltBlock registers a counter height at which the methods gated by
+// whenNotPaused start auto-reverting, without requiring an explicit Pause
+// transaction. The counter advances by one on every call that passes the
+// pause check in whenNotPaused (see bumpBlockCounter), since chaincode has
+// no direct access to the ledger's block height.
+func (t *Token) SetHaltBlock(ctx contractapi.TransactionContextInterface, height string) error {
+	if err := t.requireRole(ctx, PauserRole); err != nil {
+		return err
+	}
+
+	haltEvent := map[string]string{"haltBlock": height}
+	haltEventJSON, err := json.Marshal(haltEvent)
+	if err != nil {
+		return err
+	}
+
+	if err := ctx.GetStub().PutState(haltBlockKey, []byte(height)); err != nil {
+		return err
+	}
+
+	return ctx.GetStub().SetEvent("HaltScheduled", haltEventJSON)
+}
+
+// bumpBlockCounter advances the monotonic counter used as a block-height
+// proxy and returns its new value.
+func (t *Token) bumpBlockCounter(ctx contractapi.TransactionContextInterface) (int, error) {
+	counterBytes, err := ctx.GetStub().GetState(blockCounterKey)
+	if err != nil {
+		return 0, err
+	}
+	counter := 0
+	if counterBytes != nil {
+		counter, err = strconv.Atoi(string(counterBytes))
+		if err != nil {
+			return 0, err
+		}
+	}
+	counter++
+	if err := ctx.GetStub().PutState(blockCounterKey, []byte(strconv.Itoa(counter))); err != nil {
+		return 0, err
+	}
+	return counter, nil
+}
+
+// whenNotPaused gates Transfer, TransferFrom, Mint, Burn, TransferFreeze
+// and Unfreeze. It rejects the call if the contract is explicitly paused,
+// and also enforces any scheduled halt-block height, auto-reverting once
+// the counter reaches it even if Pause was never called. The allowance,
+// role and pause-management methods do not call it, so neither a pause
+// nor a halt height stops them.
+func (t *Token) whenNotPaused(ctx contractapi.TransactionContextInterface) error {
+	paused, err := t.IsPaused(ctx)
+	if err != nil {
+		return err
+	}
+	if paused {
+		return fmt.Errorf("token is paused")
+	}
+
+	counter, err := t.bumpBlockCounter(ctx)
+	if err != nil {
+		return err
+	}
+
+	haltBytes, err := ctx.GetStub().GetState(haltBlockKey)
+	if err != nil {
+		return err
+	}
+	if haltBytes != nil {
+		haltBlock, err := strconv.Atoi(string(haltBytes))
+		if err != nil {
+			return err
+		}
+		if counter >= haltBlock {
+			return fmt.Errorf("token is halted as of block %d", haltBlock)
+		}
+	}
+
+	return nil
+}
+
+// roleKey builds the role~<name>~<id> composite key a role grant is stored
+// under.
+func roleKey(ctx contractapi.TransactionContextInterface, role Role, id string) (string, error) {
+	return ctx.GetStub().CreateCompositeKey(rolePrefix, []string{string(role), id})
+}
+
+// grantRoleUnchecked writes a role grant without checking the caller's
+// permissions; used by InitLedger to seed the initial admin's roles.
+func (t *Token) grantRoleUnchecked(ctx contractapi.TransactionContextInterface, role Role, id string) error {
+	key, err := roleKey(ctx, role, id)
+	if err != nil {
+		return err
+	}
+	return ctx.GetStub().PutState(key, []byte("true"))
+}
+
+// HasRole reports whether id currently holds role.
+func (t *Token) HasRole(ctx contractapi.TransactionContextInterface, role string, id string) (bool, error) {
+	key, err := roleKey(ctx, Role(role), id)
+	if err != nil {
+		return false, err
+	}
+	roleBytes, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return false, err
+	}
+	return roleBytes != nil, nil
+}
+
+// requireRole returns an error unless the calling identity holds role.
+func (t *Token) requireRole(ctx contractapi.TransactionContextInterface, role Role) error {
+	caller, err := ctx.GetClientIdentity().GetID()
+	if err != nil {
+		return err
+	}
+	has, err := t.HasRole(ctx, string(role), caller)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return fmt.Errorf("client %s is missing required role %s", caller, role)
+	}
+	return nil
+}
+
+// GrantRole grants role to id. Only a DEFAULT_ADMIN_ROLE holder may call this.
+func (t *Token) GrantRole(ctx contractapi.TransactionContextInterface, role string, id string) error {
+	if err := t.requireRole(ctx, DefaultAdminRole); err != nil {
+		return err
+	}
+
+	key, err := roleKey(ctx, Role(role), id)
+	if err != nil {
+		return err
+	}
+	if err := ctx.GetStub().PutState(key, []byte("true")); err != nil {
+		return err
+	}
+
+	return t.emitRoleEvent(ctx, "RoleGranted", role, id)
+}
+
+// RevokeRole revokes role from id. Only a DEFAULT_ADMIN_ROLE holder may call
+// this.
+func (t *Token) RevokeRole(ctx contractapi.TransactionContextInterface, role string, id string) error {
+	if err := t.requireRole(ctx, DefaultAdminRole); err != nil {
+		return err
+	}
+
+	key, err := roleKey(ctx, Role(role), id)
+	if err != nil {
+		return err
+	}
+	if err := ctx.GetStub().DelState(key); err != nil {
+		return err
+	}
+
+	return t.emitRoleEvent(ctx, "RoleRevoked", role, id)
+}
+
+// RenounceRole lets the calling identity give up one of its own roles.
+func (t *Token) RenounceRole(ctx contractapi.TransactionContextInterface, role string) error {
+	caller, err := ctx.GetClientIdentity().GetID()
+	if err != nil {
+		return err
+	}
+
+	key, err := roleKey(ctx, Role(role), caller)
+	if err != nil {
+		return err
+	}
+	if err := ctx.GetStub().DelState(key); err != nil {
+		return err
+	}
+
+	return t.emitRoleEvent(ctx, "RoleRevoked", role, caller)
+}
+
+func (t *Token) emitRoleEvent(ctx contractapi.TransactionContextInterface, name string, role string, id string) error {
+	event := map[string]string{"role": role, "account": id}
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return ctx.GetStub().SetEvent(name, eventJSON)
+}
+
+// frozenKey builds the frozen~account~unlockTime composite key a single
+// frozen tranche is stored under. unlockTime is an unpadded decimal
+// Unix-seconds timestamp string, so keys under the partial key sort
+// lexicographically rather than chronologically; Unfreeze therefore
+// checks every tranche's unlockTime.
+func frozenKey(ctx contractapi.TransactionContextInterface, account string, unlockTime string) (string, error) {
+	return ctx.GetStub().CreateCompositeKey(frozenPrefix, []string{account, unlockTime})
+}
+
+// TransferFreeze moves amount out of the calling client's own liquid
+// balance and into a frozen tranche held for to, which matures at
+// unlockTime (Unix seconds). The sender is derived from
+// ctx.GetClientIdentity().GetID() rather than taken as an argument, the
+// same as Transfer, so a caller cannot spoof another account's identity
+// and lock away its balance. The liquid BalanceOf/Transfer/Burn paths
+// never see frozen funds; only Unfreeze moves a matured tranche back to
+// the liquid balance.
+func (t *Token) TransferFreeze(ctx contractapi.TransactionContextInterface, to string, amount string, unlockTime string) error {
+	if err := t.whenNotPaused(ctx); err != nil {
+		return err
+	}
+
+	from, err := ctx.GetClientIdentity().GetID()
+	if err != nil {
+		return err
+	}
+
+	if err := validateAccountID(to); err != nil {
+		return err
+	}
+
+	if _, err := strconv.ParseInt(unlockTime, 10, 64); err != nil {
+		return fmt.Errorf("invalid unlockTime %s: %w", unlockTime, err)
+	}
+
+	decimals, err := t.getDecimals(ctx)
+	if err != nil {
+		return err
+	}
+	amountInt, err := humanToBaseUnits(amount, decimals)
+	if err != nil {
+		return err
+	}
+	if amountInt.Sign() <= 0 {
+		return fmt.Errorf("amount must be greater than 0")
+	}
+
+	fromBalance, err := t.BalanceOf(ctx, from)
+	if err != nil {
+		return err
+	}
+	fromBalanceInt, err := parseBigInt(fromBalance)
+	if err != nil {
+		return err
+	}
+	if fromBalanceInt.Cmp(amountInt) < 0 {
+		return fmt.Errorf("the account %s does not have enough balance", from)
+	}
+	newFromBalance := new(big.Int).Sub(fromBalanceInt, amountInt)
+
+	key, err := frozenKey(ctx, to, unlockTime)
+	if err != nil {
+		return err
+	}
+	existingBytes, err := ctx.GetStub().GetState(key)
+	if err != nil {
+		return err
+	}
+	existing := big.NewInt(0)
+	if existingBytes != nil {
+		existing, err = parseBigInt(string(existingBytes))
+		if err != nil {
+			return err
+		}
+	}
+	newFrozen := new(big.Int).Add(existing, amountInt)
+
+	fromBalanceKey, err := balanceKey(ctx, from)
+	if err != nil {
+		return err
+	}
+	if err := ctx.GetStub().PutState(fromBalanceKey, []byte(newFromBalance.String())); err != nil {
+		return err
+	}
+	if err := ctx.GetStub().PutState(key, []byte(newFrozen.String())); err != nil {
+		return err
+	}
+
+	event := map[string]string{"from": from, "to": to, "amount": amountInt.String(), "unlockTime": unlockTime}
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return ctx.GetStub().SetEvent("Freeze", eventJSON)
+}
+
+// FrozenBalanceOf returns the sum of every frozen tranche still held for
+// account, matured or not: a matured tranche counts here until Unfreeze
+// moves it to the liquid balance. It does not include the liquid balance
+// returned by BalanceOf.
+func (t *Token) FrozenBalanceOf(ctx contractapi.TransactionContextInterface, account string) (string, error) {
+	if err := validateAccountID(account); err != nil {
+		return "", err
+	}
+
+	iterator, err := ctx.GetStub().GetStateByPartialCompositeKey(frozenPrefix, []string{account})
+	if err != nil {
+		return "", err
+	}
+	defer iterator.Close()
+
+	total := big.NewInt(0)
+	for iterator.HasNext() {
+		kv, err := iterator.Next()
+		if err != nil {
+			return "", err
+		}
+		amt, err := parseBigInt(string(kv.Value))
+		if err != nil {
+			return "", err
+		}
+		total.Add(total, amt)
+	}
+	return total.String(), nil
+}
+
+// Unfreeze moves every matured tranche (unlockTime <= the current
+// transaction's timestamp) belonging to account back into its liquid
+// balance, removing the matured tranche keys.
+func (t *Token) Unfreeze(ctx contractapi.TransactionContextInterface, account string) error {
+	if err := t.whenNotPaused(ctx); err != nil {
+		return err
+	}
+
+	if err := validateAccountID(account); err != nil {
+		return err
+	}
+
+	txTimestamp, err := ctx.GetStub().GetTxTimestamp()
+	if err != nil {
+		return err
+	}
+	now := txTimestamp.Seconds
+
+	iterator, err := ctx.GetStub().GetStateByPartialCompositeKey(frozenPrefix, []string{account})
+	if err != nil {
+		return err
+	}
+
+	type tranche struct {
+		key    string
+		amount *big.Int
+	}
+	var matured []tranche
+
+	for iterator.HasNext() {
+		kv, err := iterator.Next()
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		_, parts, err := ctx.GetStub().SplitCompositeKey(kv.Key)
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		unlockTime, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		if unlockTime > now {
+			continue
+		}
+		amt, err := parseBigInt(string(kv.Value))
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		matured = append(matured, tranche{key: kv.Key, amount: amt})
+	}
+	iterator.Close()
+
+	if len(matured) == 0 {
+		return fmt.Errorf("account %s has no matured frozen tranches", account)
+	}
+
+	released := big.NewInt(0)
+	for _, tr := range matured {
+		released.Add(released, tr.amount)
+		if err := ctx.GetStub().DelState(tr.key); err != nil {
+			return err
+		}
+	}
+
+	liquidBalanceInt, err := t.creditableBalance(ctx, account)
+	if err != nil {
+		return err
+	}
+	newBalance := new(big.Int).Add(liquidBalanceInt, released)
+	accountBalanceKey, err := balanceKey(ctx, account)
+	if err != nil {
+		return err
+	}
+	if err := ctx.GetStub().PutState(accountBalanceKey, []byte(newBalance.String())); err != nil {
+		return err
+	}
+
+	event := map[string]string{"account": account, "amount": released.String()}
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return ctx.GetStub().SetEvent("Unfreeze", eventJSON)
+}
+
+// BalanceHistoryRecord is one historical mutation of an account's balance
+// key, as recorded by Fabric's block history. It approximates a "transfer
+// touching this account" entry: IsDelete marks a key deletion and Value is
+// the resulting balance after that transaction, not the transfer delta.
+type BalanceHistoryRecord struct {
+	TxID      string `json:"txId"`
+	Timestamp int64  `json:"timestamp"`
+	Value     string `json:"value"`
+	IsDelete  bool   `json:"isDelete"`
+}
+
+// HistoryPage is a single page of BalanceHistoryRecords, with Bookmark set
+// to a non-empty value when more records are available.
+type HistoryPage struct {
+	Records  []BalanceHistoryRecord `json:"records"`
+	Bookmark string                 `json:"bookmark"`
+}
+
+// TransferHistory returns a page of account's balance-key history, newest
+// first per Fabric's block order, so an off-chain indexer can follow an
+// account's transfer activity without replaying every Transfer event from
+// genesis. Fabric's history iterator has no native pagination, so bookmark
+// is the decimal count of records already seen.
+func (t *Token) TransferHistory(ctx contractapi.TransactionContextInterface, account string, pageSize string, bookmark string) (*HistoryPage, error) {
+	if err := validateAccountID(account); err != nil {
+		return nil, err
+	}
+
+	size, err := strconv.Atoi(pageSize)
+	if err != nil || size <= 0 {
+		return nil, fmt.Errorf("pageSize must be a positive integer: %s", pageSize)
+	}
+
+	skip := 0
+	if bookmark != "" {
+		skip, err = strconv.Atoi(bookmark)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bookmark: %s", bookmark)
+		}
+	}
+
+	key, err := balanceKey(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	iterator, err := ctx.GetStub().GetHistoryForKey(key)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	seen := 0
+	records := []BalanceHistoryRecord{}
+	for iterator.HasNext() {
+		if len(records) >= size {
+			break
+		}
+		mod, err := iterator.Next()
+		if err != nil {
+			return nil, err
+		}
+		if seen < skip {
+			seen++
+			continue
+		}
+		records = append(records, BalanceHistoryRecord{
+			TxID:      mod.TxId,
+			Timestamp: mod.Timestamp.GetSeconds(),
+			Value:     string(mod.Value),
+			IsDelete:  mod.IsDelete,
+		})
+		seen++
+	}
+
+	nextBookmark := ""
+	if iterator.HasNext() {
+		nextBookmark = strconv.Itoa(seen)
+	}
+	return &HistoryPage{Records: records, Bookmark: nextBookmark}, nil
+}
+
+// BalanceAt returns account's balance as recorded by the transaction txID,
+// found by walking the balance key's history. It returns an error if txID
+// never touched account's balance.
+func (t *Token) BalanceAt(ctx contractapi.TransactionContextInterface, account string, txID string) (string, error) {
+	if err := validateAccountID(account); err != nil {
+		return "", err
+	}
+
+	key, err := balanceKey(ctx, account)
+	if err != nil {
+		return "", err
+	}
+	iterator, err := ctx.GetStub().GetHistoryForKey(key)
+	if err != nil {
+		return "", err
+	}
+	defer iterator.Close()
+
+	for iterator.HasNext() {
+		mod, err := iterator.Next()
+		if err != nil {
+			return "", err
+		}
+		if mod.TxId != txID {
+			continue
+		}
+		if mod.IsDelete {
+			return "0", nil
+		}
+		return string(mod.Value), nil
+	}
+	return "", fmt.Errorf("no balance history for account %s at transaction %s", account, txID)
+}
+
+// HolderRecord is a single non-zero balance holder surfaced by HolderList.
+type HolderRecord struct {
+	Account string `json:"account"`
+	Balance string `json:"balance"`
+}
+
+// HolderPage is a single page of HolderRecords, with Bookmark set to a
+// non-empty value when more records are available.
+type HolderPage struct {
+	Holders  []HolderRecord `json:"holders"`
+	Bookmark string         `json:"bookmark"`
+}
+
+// HolderList enumerates every account with a non-zero balance, via
+// paginated composite-key iteration over the balance~account keyspace, so
+// an off-chain indexer doesn't need to track holders itself.
+func (t *Token) HolderList(ctx contractapi.TransactionContextInterface, pageSize string, bookmark string) (*HolderPage, error) {
+	size, err := strconv.Atoi(pageSize)
+	if err != nil || size <= 0 {
+		return nil, fmt.Errorf("pageSize must be a positive integer: %s", pageSize)
+	}
+
+	iterator, metadata, err := ctx.GetStub().GetStateByPartialCompositeKeyWithPagination(balancePrefix, []string{}, int32(size), bookmark)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	holders := []HolderRecord{}
+	for iterator.HasNext() {
+		kv, err := iterator.Next()
+		if err != nil {
+			return nil, err
+		}
+		_, parts, err := ctx.GetStub().SplitCompositeKey(kv.Key)
+		if err != nil {
+			return nil, err
+		}
+		balance, err := parseBigInt(string(kv.Value))
+		if err != nil {
+			return nil, err
+		}
+		if balance.Sign() <= 0 {
+			continue
+		}
+		holders = append(holders, HolderRecord{Account: parts[0], Balance: balance.String()})
+	}
+
+	return &HolderPage{Holders: holders, Bookmark: metadata.Bookmark}, nil
+}
@@ -0,0 +1,302 @@
+package chaincode
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/fabric-chaincode-go/pkg/cid"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+	"github.com/hyperledger/fabric-protos-go/ledger/queryresult"
+)
+
+// mockStub is a minimal in-memory shim.ChaincodeStubInterface. Embedding
+// the interface (left nil) satisfies every method the tests below never
+// call, so only the state/event/composite-key operations the token
+// actually uses need a real implementation.
+type mockStub struct {
+	shim.ChaincodeStubInterface
+	state map[string][]byte
+}
+
+func newMockStub() *mockStub {
+	return &mockStub{state: map[string][]byte{}}
+}
+
+func (m *mockStub) GetState(key string) ([]byte, error) {
+	return m.state[key], nil
+}
+
+func (m *mockStub) PutState(key string, value []byte) error {
+	m.state[key] = value
+	return nil
+}
+
+func (m *mockStub) DelState(key string) error {
+	delete(m.state, key)
+	return nil
+}
+
+func (m *mockStub) CreateCompositeKey(objectType string, attributes []string) (string, error) {
+	return objectType + "\x00" + strings.Join(attributes, "\x00"), nil
+}
+
+func (m *mockStub) SplitCompositeKey(compositeKey string) (string, []string, error) {
+	parts := strings.Split(compositeKey, "\x00")
+	return parts[0], parts[1:], nil
+}
+
+func (m *mockStub) GetStateByPartialCompositeKey(objectType string, attributes []string) (shim.StateQueryIteratorInterface, error) {
+	prefix := objectType + "\x00" + strings.Join(attributes, "\x00") + "\x00"
+	it := &mockIterator{}
+	for k, v := range m.state {
+		if strings.HasPrefix(k, prefix) {
+			it.kvs = append(it.kvs, &queryresult.KV{Key: k, Value: v})
+		}
+	}
+	return it, nil
+}
+
+// mockIterator is a shim.StateQueryIteratorInterface over a fixed slice.
+type mockIterator struct {
+	kvs []*queryresult.KV
+}
+
+func (it *mockIterator) HasNext() bool { return len(it.kvs) > 0 }
+
+func (it *mockIterator) Close() error { return nil }
+
+func (it *mockIterator) Next() (*queryresult.KV, error) {
+	kv := it.kvs[0]
+	it.kvs = it.kvs[1:]
+	return kv, nil
+}
+
+func (m *mockStub) SetEvent(name string, payload []byte) error {
+	return nil
+}
+
+// mockClientIdentity is a minimal cid.ClientIdentity returning a fixed
+// caller ID, which is all the tests below need from it.
+type mockClientIdentity struct {
+	cid.ClientIdentity
+	id string
+}
+
+func (m *mockClientIdentity) GetID() (string, error) {
+	return m.id, nil
+}
+
+// newTestContext returns a TransactionContext backed by a fresh mockStub,
+// with the calling identity fixed to caller.
+func newTestContext(caller string) (*contractapi.TransactionContext, *mockStub) {
+	stub := newMockStub()
+	ctx := &contractapi.TransactionContext{}
+	ctx.SetStub(stub)
+	ctx.SetClientIdentity(&mockClientIdentity{id: caller})
+	return ctx, stub
+}
+
+func initTestLedger(t *testing.T, token *Token, ctx contractapi.TransactionContextInterface, admin string, maxSupply string) {
+	t.Helper()
+	if err := token.InitLedger(ctx, "Test Token", "TST", "0", maxSupply, admin); err != nil {
+		t.Fatalf("InitLedger: %v", err)
+	}
+}
+
+func TestMintHandlesValuesNearTwoTo256(t *testing.T) {
+	token := &Token{}
+	ctx, _ := newTestContext("admin")
+	initTestLedger(t, token, ctx, "admin", "0")
+
+	// 2^256 - 1, the largest value an EVM-style uint256 balance can hold.
+	huge := "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+	if err := token.Mint(ctx, "holder", huge); err != nil {
+		t.Fatalf("Mint huge value: %v", err)
+	}
+
+	balance, err := token.BalanceOf(ctx, "holder")
+	if err != nil {
+		t.Fatalf("BalanceOf: %v", err)
+	}
+	if balance != huge {
+		t.Fatalf("balance = %s, want %s", balance, huge)
+	}
+
+	totalSupply, err := token.TotalSupply(ctx)
+	if err != nil {
+		t.Fatalf("TotalSupply: %v", err)
+	}
+	if totalSupply != huge {
+		t.Fatalf("totalSupply = %s, want %s", totalSupply, huge)
+	}
+
+	// Minting one more unit must not silently wrap/truncate the way
+	// strconv.Atoi-based arithmetic would at machine-word size.
+	if err := token.Mint(ctx, "holder", "1"); err != nil {
+		t.Fatalf("Mint additional unit: %v", err)
+	}
+	want := "115792089237316195423570985008687907853269984665640564039457584007913129639936"
+	balance, err = token.BalanceOf(ctx, "holder")
+	if err != nil {
+		t.Fatalf("BalanceOf: %v", err)
+	}
+	if balance != want {
+		t.Fatalf("balance after second mint = %s, want %s", balance, want)
+	}
+}
+
+func TestMintRejectsZeroAmount(t *testing.T) {
+	token := &Token{}
+	ctx, _ := newTestContext("admin")
+	initTestLedger(t, token, ctx, "admin", "0")
+
+	if err := token.Mint(ctx, "holder", "0"); err == nil {
+		t.Fatal("expected error minting a zero amount, got nil")
+	}
+}
+
+func TestTransferRejectsZeroAmount(t *testing.T) {
+	token := &Token{}
+	ctx, _ := newTestContext("admin")
+	initTestLedger(t, token, ctx, "admin", "0")
+
+	if err := token.Mint(ctx, "admin", "100"); err != nil {
+		t.Fatalf("Mint: %v", err)
+	}
+	if err := token.Transfer(ctx, "holder", "0"); err == nil {
+		t.Fatal("expected error transferring a zero amount, got nil")
+	}
+}
+
+func TestDecreaseAllowanceUnderflowRejected(t *testing.T) {
+	token := &Token{}
+	ctx, _ := newTestContext("owner")
+	initTestLedger(t, token, ctx, "owner", "0")
+
+	if err := token.Approve(ctx, "spender", "10"); err != nil {
+		t.Fatalf("Approve: %v", err)
+	}
+	if err := token.DecreaseAllowance(ctx, "spender", "11"); err == nil {
+		t.Fatal("expected error decreasing allowance below zero, got nil")
+	}
+
+	allowance, err := token.Allowance(ctx, "owner", "spender")
+	if err != nil {
+		t.Fatalf("Allowance: %v", err)
+	}
+	if allowance != "10" {
+		t.Fatalf("allowance after rejected decrease = %s, want unchanged 10", allowance)
+	}
+}
+
+func TestTransferFromRejectsInsufficientAllowance(t *testing.T) {
+	token := &Token{}
+	ctx, _ := newTestContext("owner")
+	initTestLedger(t, token, ctx, "owner", "0")
+
+	if err := token.Mint(ctx, "owner", "100"); err != nil {
+		t.Fatalf("Mint: %v", err)
+	}
+	if err := token.Approve(ctx, "spender", "5"); err != nil {
+		t.Fatalf("Approve: %v", err)
+	}
+
+	if err := token.TransferFrom(ctx, "owner", "spender", "10"); err == nil {
+		t.Fatal("expected error spending beyond the allowance, got nil")
+	}
+}
+
+func TestTransferToNeverFundedRecipientSucceeds(t *testing.T) {
+	token := &Token{}
+	ctx, _ := newTestContext("owner")
+	initTestLedger(t, token, ctx, "owner", "0")
+
+	if err := token.Mint(ctx, "owner", "100"); err != nil {
+		t.Fatalf("Mint: %v", err)
+	}
+	if err := token.Transfer(ctx, "fresh-recipient", "40"); err != nil {
+		t.Fatalf("Transfer to a never-funded recipient: %v", err)
+	}
+
+	balance, err := token.BalanceOf(ctx, "fresh-recipient")
+	if err != nil {
+		t.Fatalf("BalanceOf: %v", err)
+	}
+	if balance != "40" {
+		t.Fatalf("fresh-recipient balance = %s, want 40", balance)
+	}
+}
+
+func TestInitLedgerRejectsNegativeDecimals(t *testing.T) {
+	token := &Token{}
+	ctx, _ := newTestContext("admin")
+
+	if err := token.InitLedger(ctx, "Test Token", "TST", "-1", "0", "admin"); err == nil {
+		t.Fatal("expected error initializing with negative decimals, got nil")
+	}
+}
+
+func TestTransferFreezeDebitsOnlyCallersOwnBalance(t *testing.T) {
+	token := &Token{}
+	ctx, _ := newTestContext("owner")
+	initTestLedger(t, token, ctx, "owner", "0")
+
+	if err := token.Mint(ctx, "owner", "100"); err != nil {
+		t.Fatalf("Mint: %v", err)
+	}
+	if err := token.TransferFreeze(ctx, "recipient", "40", "0"); err != nil {
+		t.Fatalf("TransferFreeze: %v", err)
+	}
+
+	ownerBalance, err := token.BalanceOf(ctx, "owner")
+	if err != nil {
+		t.Fatalf("BalanceOf: %v", err)
+	}
+	if ownerBalance != "60" {
+		t.Fatalf("owner liquid balance = %s, want 60", ownerBalance)
+	}
+
+	frozen, err := token.FrozenBalanceOf(ctx, "recipient")
+	if err != nil {
+		t.Fatalf("FrozenBalanceOf: %v", err)
+	}
+	if frozen != "40" {
+		t.Fatalf("recipient frozen balance = %s, want 40", frozen)
+	}
+}
+
+func TestTransferFreezeRejectsWhenCallerLacksBalance(t *testing.T) {
+	token := &Token{}
+	ctx, _ := newTestContext("owner")
+	initTestLedger(t, token, ctx, "owner", "0")
+
+	if err := token.Mint(ctx, "victim", "100"); err != nil {
+		t.Fatalf("Mint: %v", err)
+	}
+
+	// Attempting to freeze funds out of "victim" is only ever possible
+	// from victim's own liquid balance: the caller "owner" has none, so
+	// victim's balance can't be reached through this call at all.
+	if err := token.TransferFreeze(ctx, "attacker", "40", "0"); err == nil {
+		t.Fatal("expected error freezing funds the caller doesn't hold, got nil")
+	}
+
+	victimBalance, err := token.BalanceOf(ctx, "victim")
+	if err != nil {
+		t.Fatalf("BalanceOf: %v", err)
+	}
+	if victimBalance != "100" {
+		t.Fatalf("victim balance = %s, want unchanged 100", victimBalance)
+	}
+}
+
+func TestBurnOnNeverMintedAccountIsRejectedCleanly(t *testing.T) {
+	token := &Token{}
+	ctx, _ := newTestContext("admin")
+	initTestLedger(t, token, ctx, "admin", "0")
+
+	if err := token.Burn(ctx, "admin", "1"); err == nil {
+		t.Fatal("expected error burning from an account that never minted, got nil")
+	}
+}